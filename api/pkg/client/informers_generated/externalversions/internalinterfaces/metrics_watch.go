@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+package internalinterfaces
+
+import (
+	sync "sync"
+
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	watch "k8s.io/apimachinery/pkg/watch"
+)
+
+// metricsWatch wraps a watch.Interface so every event it relays for resource is first reported to
+// an InformerMetricsProvider. Generated WatchFuncs use it when a provider is configured.
+type metricsWatch struct {
+	watch.Interface
+	resource schema.GroupVersionResource
+	metrics  InformerMetricsProvider
+	out      chan watch.Event
+
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewMetricsWatch returns a watch.Interface that reports each event it relays for resource to
+// metrics before forwarding it to the caller, and closes its output channel once w's does.
+func NewMetricsWatch(w watch.Interface, resource schema.GroupVersionResource, metrics InformerMetricsProvider) watch.Interface {
+	mw := &metricsWatch{
+		Interface: w,
+		resource:  resource,
+		metrics:   metrics,
+		out:       make(chan watch.Event),
+		done:      make(chan struct{}),
+	}
+	go mw.relay()
+	return mw
+}
+
+func (w *metricsWatch) relay() {
+	defer close(w.out)
+	for event := range w.Interface.ResultChan() {
+		w.metrics.ObserveWatchEvent(w.resource, event.Type)
+		select {
+		case w.out <- event:
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *metricsWatch) ResultChan() <-chan watch.Event {
+	return w.out
+}
+
+// Stop stops the underlying watch and unblocks relay if it is parked sending an event nobody will
+// ever read again - otherwise a caller that stops watching without draining ResultChan (as
+// cache.Reflector does on stop-channel/context cancellation) leaks relay's goroutine forever.
+func (w *metricsWatch) Stop() {
+	w.Interface.Stop()
+	w.stopOnce.Do(func() {
+		close(w.done)
+	})
+}
@@ -14,10 +14,15 @@ import (
 	v3 "github.com/projectcalico/api/pkg/client/listers_generated/projectcalico/v3"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
 	cache "k8s.io/client-go/tools/cache"
 )
 
+// stagedGlobalNetworkPoliciesResource is the GroupVersionResource used to look up a configured
+// cache.TransformFunc for this type via internalinterfaces.SharedInformerFactory.TransformFor.
+var stagedGlobalNetworkPoliciesResource = schema.GroupVersionResource{Group: "projectcalico.org", Version: "v3", Resource: "stagedglobalnetworkpolicies"}
+
 // StagedGlobalNetworkPolicyInformer provides access to a shared informer and lister for
 // StagedGlobalNetworkPolicies.
 type StagedGlobalNetworkPolicyInformer interface {
@@ -33,37 +38,62 @@ type stagedGlobalNetworkPolicyInformer struct {
 // NewStagedGlobalNetworkPolicyInformer constructs a new informer for StagedGlobalNetworkPolicy type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
-func NewStagedGlobalNetworkPolicyInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
-	return NewFilteredStagedGlobalNetworkPolicyInformer(client, resyncPeriod, indexers, nil)
+func NewStagedGlobalNetworkPolicyInformer(ctx context.Context, client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredStagedGlobalNetworkPolicyInformer(ctx, client, resyncPeriod, indexers, nil, nil, nil)
 }
 
 // NewFilteredStagedGlobalNetworkPolicyInformer constructs a new informer for StagedGlobalNetworkPolicy type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
-func NewFilteredStagedGlobalNetworkPolicyInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
-	return cache.NewSharedIndexInformer(
+func NewFilteredStagedGlobalNetworkPolicyInformer(ctx context.Context, client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc, transform cache.TransformFunc, metrics internalinterfaces.InformerMetricsProvider) cache.SharedIndexInformer {
+	// watchStarted tracks whether WatchFunc has already connected once, so the first connection
+	// isn't counted as a restart. The reflector drives ListFunc/WatchFunc sequentially from a
+	// single goroutine, so this needs no synchronization of its own.
+	watchStarted := false
+	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
 			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
 				if tweakListOptions != nil {
 					tweakListOptions(&options)
 				}
-				return client.ProjectcalicoV3().StagedGlobalNetworkPolicies().List(context.TODO(), options)
+				start := time.Now()
+				result, err := client.ProjectcalicoV3().StagedGlobalNetworkPolicies().List(ctx, options)
+				if metrics != nil {
+					metrics.ObserveListDuration(stagedGlobalNetworkPoliciesResource, time.Since(start), err)
+				}
+				return result, err
 			},
 			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
 				if tweakListOptions != nil {
 					tweakListOptions(&options)
 				}
-				return client.ProjectcalicoV3().StagedGlobalNetworkPolicies().Watch(context.TODO(), options)
+				w, err := client.ProjectcalicoV3().StagedGlobalNetworkPolicies().Watch(ctx, options)
+				if err != nil || metrics == nil {
+					return w, err
+				}
+				// The reflector calls WatchFunc again each time it needs to re-establish a watch
+				// that ended, so every call after the first here is a restart.
+				if watchStarted {
+					metrics.IncWatchRestart(stagedGlobalNetworkPoliciesResource)
+				}
+				watchStarted = true
+				return internalinterfaces.NewMetricsWatch(w, stagedGlobalNetworkPoliciesResource, metrics), nil
 			},
 		},
 		&projectcalicov3.StagedGlobalNetworkPolicy{},
 		resyncPeriod,
 		indexers,
 	)
+	if transform != nil {
+		informer.SetTransform(transform)
+	}
+	return informer
 }
 
 func (f *stagedGlobalNetworkPolicyInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
-	return NewFilteredStagedGlobalNetworkPolicyInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+	informer := NewFilteredStagedGlobalNetworkPolicyInformer(f.factory.Context(), client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions, f.factory.TransformFor(stagedGlobalNetworkPoliciesResource), f.factory.Metrics())
+	f.factory.TrackCacheSize(stagedGlobalNetworkPoliciesResource, informer)
+	return informer
 }
 
 func (f *stagedGlobalNetworkPolicyInformer) Informer() cache.SharedIndexInformer {
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+package externalversions
+
+import (
+	"fmt"
+
+	meta "k8s.io/apimachinery/pkg/api/meta"
+)
+
+// lastAppliedConfigAnnotation is the annotation kubectl apply stamps on objects, which is large
+// and not meaningful to controllers consuming calico informers.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// StripManagedFieldsAndLastApplied is a cache.TransformFunc that drops ObjectMeta.ManagedFields
+// and the kubectl.kubernetes.io/last-applied-configuration annotation from an object before it is
+// stored in an informer's indexer. Calico policy types in particular can carry large managed
+// fields and last-applied blobs that most controllers never read, and this keeps the in-memory
+// cache smaller without changing what List/Watch returns from the API server.
+//
+// It is opt-in: the factory applies no transform unless a caller passes one in. Pass this to
+// WithTransform to strip it from every resource, or to WithTransformForResource to be aggressive
+// on specific policy types (e.g. StagedGlobalNetworkPolicy) while leaving others, such as Node,
+// untouched.
+func StripManagedFieldsAndLastApplied(obj interface{}) (interface{}, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, fmt.Errorf("stripping managed fields: %w", err)
+	}
+
+	accessor.SetManagedFields(nil)
+
+	annotations := accessor.GetAnnotations()
+	if _, ok := annotations[lastAppliedConfigAnnotation]; ok {
+		delete(annotations, lastAppliedConfigAnnotation)
+		accessor.SetAnnotations(annotations)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Package metrics provides a Prometheus-backed internalinterfaces.InformerMetricsProvider for the
+// generated informers under externalversions. It is entirely optional: the generated informers
+// depend only on the product-agnostic InformerMetricsProvider interface, so consumers who prefer
+// OpenTelemetry or another backend can implement that interface directly instead of importing
+// this package.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	watch "k8s.io/apimachinery/pkg/watch"
+
+	internalinterfaces "github.com/projectcalico/api/pkg/client/informers_generated/externalversions/internalinterfaces"
+)
+
+// PrometheusProvider is an internalinterfaces.InformerMetricsProvider backed by Prometheus
+// metrics, labeled by the resource's group, version, and resource name.
+type PrometheusProvider struct {
+	listDuration  *prometheus.HistogramVec
+	watchEvents   *prometheus.CounterVec
+	watchRestarts *prometheus.CounterVec
+	cacheSize     *prometheus.GaugeVec
+}
+
+var _ internalinterfaces.InformerMetricsProvider = (*PrometheusProvider)(nil)
+
+// NewPrometheusProvider constructs a PrometheusProvider and registers its metrics with reg.
+func NewPrometheusProvider(reg prometheus.Registerer) *PrometheusProvider {
+	p := &PrometheusProvider{
+		listDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "calico_informer_list_duration_seconds",
+			Help: "Duration of List calls made by generated calico informers.",
+		}, []string{"group", "version", "resource", "result"}),
+		watchEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "calico_informer_watch_events_total",
+			Help: "Number of watch events delivered to generated calico informers.",
+		}, []string{"group", "version", "resource", "event_type"}),
+		watchRestarts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "calico_informer_watch_restarts_total",
+			Help: "Number of times a generated calico informer had to re-establish its watch after a prior one ended; does not count the initial connection.",
+		}, []string{"group", "version", "resource"}),
+		cacheSize: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "calico_informer_cache_size",
+			Help: "Number of objects currently held in a generated calico informer's local cache.",
+		}, []string{"group", "version", "resource"}),
+	}
+	reg.MustRegister(p.listDuration, p.watchEvents, p.watchRestarts, p.cacheSize)
+	return p
+}
+
+// ObserveListDuration implements internalinterfaces.InformerMetricsProvider.
+func (p *PrometheusProvider) ObserveListDuration(resource schema.GroupVersionResource, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	p.listDuration.WithLabelValues(resource.Group, resource.Version, resource.Resource, result).Observe(duration.Seconds())
+}
+
+// ObserveWatchEvent implements internalinterfaces.InformerMetricsProvider.
+func (p *PrometheusProvider) ObserveWatchEvent(resource schema.GroupVersionResource, eventType watch.EventType) {
+	p.watchEvents.WithLabelValues(resource.Group, resource.Version, resource.Resource, string(eventType)).Inc()
+}
+
+// ObserveCacheSize implements internalinterfaces.InformerMetricsProvider.
+func (p *PrometheusProvider) ObserveCacheSize(resource schema.GroupVersionResource, size int) {
+	p.cacheSize.WithLabelValues(resource.Group, resource.Version, resource.Resource).Set(float64(size))
+}
+
+// IncWatchRestart implements internalinterfaces.InformerMetricsProvider.
+func (p *PrometheusProvider) IncWatchRestart(resource schema.GroupVersionResource) {
+	p.watchRestarts.WithLabelValues(resource.Group, resource.Version, resource.Resource).Inc()
+}